@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/auth"
+)
+
+// LoginRequest is the dev-login payload matched against the gateway's
+// configured static users file.
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// LoginResponse carries the bearer token a client should send as
+// "Authorization: Bearer <token>" on subsequent requests.
+type LoginResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+const loginTokenTTL = time.Hour
+
+// Login handles POST /auth/login: a dev convenience that issues HS256
+// tokens for accounts in the configured static users file. There is no
+// real identity provider here; production deployments should point
+// AUTH_JWKS_URL at one instead and leave this endpoint unused.
+func (s *Server) Login(c *gin.Context) {
+	var req LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid_request", Code: 400, Message: "Invalid request format"})
+		return
+	}
+
+	user, ok := s.AuthUsers[req.Username]
+	if !ok || user.Password != req.Password {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "invalid_credentials", Code: 401, Message: "Unknown username or password"})
+		return
+	}
+
+	token, err := auth.SignHS256(s.AuthSecret, auth.Claims{Sub: user.Sub, Scopes: user.Scopes, Tenant: user.Tenant}, loginTokenTTL)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "token_issue_failed", Code: 500, Message: "Failed to issue token"})
+		return
+	}
+
+	c.JSON(http.StatusOK, LoginResponse{AccessToken: token, TokenType: "Bearer", ExpiresIn: int(loginTokenTTL.Seconds())})
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/auth"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/middleware"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/models"
+)
+
+// Documents handles GET /documents, paginated via ?page=&page_size=. It
+// queries the DB directly rather than through s.Cache, so there's no
+// cache key here to scope per user the way query/generate/models are —
+// scoping instead happens via the owner_sub/tenant WHERE clause below, the
+// same claims cacheScope would have used.
+func (s *Server) Documents(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if page < 1 {
+		page = 1
+	}
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	claims, _ := auth.FromContext(c)
+
+	ctx, span := middleware.StartSpan(c.Request.Context(), "db.documents.list")
+	defer span.End()
+
+	owned := func() *gorm.DB {
+		return s.DB.WithContext(ctx).Model(&models.Document{}).
+			Where("owner_sub = ? AND tenant = ?", claims.Sub, claims.Tenant)
+	}
+
+	var rows []models.Document
+	var total int64
+	if err := owned().Count(&total).Error; err != nil {
+		c.JSON(500, ErrorResponse{Error: "db_error", Code: 500, Message: "Failed to count documents"})
+		return
+	}
+	if err := owned().Order("uploaded_at desc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&rows).Error; err != nil {
+		c.JSON(500, ErrorResponse{Error: "db_error", Code: 500, Message: "Failed to list documents"})
+		return
+	}
+
+	documents := make([]Document, 0, len(rows))
+	for _, row := range rows {
+		documents = append(documents, Document{ID: row.DocumentID, Title: row.Title, Type: row.MIME})
+	}
+
+	c.JSON(200, DocumentsResponse{
+		Documents: documents,
+		Total:     int(total),
+		Status:    "success",
+	})
+}
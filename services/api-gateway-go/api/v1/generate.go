@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/auth"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/middleware"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/tasks"
+)
+
+// Generate handles POST /generate, mirroring Query's cache-then-queue shape.
+func (s *Server) Generate(c *gin.Context) {
+	var req GenerateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(400, ErrorResponse{Error: "invalid_request", Code: 400, Message: "Invalid request format"})
+		return
+	}
+
+	if req.Prompt == "" {
+		c.JSON(400, ErrorResponse{Error: "missing_prompt", Code: 400, Message: "Prompt is required"})
+		return
+	}
+
+	cacheKey := fmt.Sprintf("generate:%s:%s", cacheScope(c), req.Prompt)
+
+	ctx, span := middleware.StartSpan(c.Request.Context(), "cache.lookup")
+	cached, found := s.Cache.Get(cacheKey)
+	span.End()
+	if found {
+		c.JSON(200, cached)
+		return
+	}
+
+	claims, _ := auth.FromContext(c)
+	taskID, queue, err := s.Tasks.EnqueueGenerate(ctx, tasks.GeneratePayload{
+		Prompt:      req.Prompt,
+		CallbackURL: req.CallbackURL,
+	}, tasks.Owner{Sub: claims.Sub, Tenant: claims.Tenant})
+	if err != nil {
+		c.JSON(500, ErrorResponse{Error: "enqueue_failed", Code: 500, Message: "Failed to schedule generation"})
+		return
+	}
+
+	s.awaitOrAccept(c, queue, taskID, cacheKey)
+}
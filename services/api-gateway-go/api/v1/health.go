@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Health reports service identity only; request/error counts now live in
+// Prometheus (see middleware.Metrics) rather than this JSON body.
+func (s *Server) Health(c *gin.Context) {
+	c.JSON(200, HealthResponse{
+		ServiceName: "api-gateway-go",
+		Status:      "healthy",
+		Timestamp:   time.Now(),
+		Version:     "2.0.0",
+	})
+}
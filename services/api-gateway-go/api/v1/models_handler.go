@@ -0,0 +1,27 @@
+package v1
+
+import "github.com/gin-gonic/gin"
+
+// ListModels handles GET /models. The catalog is static for now; caching
+// just avoids rebuilding the response struct on every call.
+func (s *Server) ListModels(c *gin.Context) {
+	cacheKey := "models:list:" + cacheScope(c)
+	if cached, found := s.Cache.Get(cacheKey); found {
+		c.JSON(200, cached)
+		return
+	}
+
+	response := ModelsResponse{
+		Models: []Model{
+			{Name: "gpt-4-turbo", Type: "llm", Status: "available"},
+			{Name: "text-embedding-3-large", Type: "embedding", Status: "available"},
+			{Name: "claude-3-opus", Type: "llm", Status: "available"},
+			{Name: "gemini-pro", Type: "llm", Status: "available"},
+		},
+		Total:  4,
+		Status: "success",
+	}
+
+	s.Cache.Set(cacheKey, response)
+	c.JSON(200, response)
+}
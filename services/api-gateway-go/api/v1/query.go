@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/auth"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/middleware"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/tasks"
+)
+
+// Query handles POST /query: a cache hit answers immediately, otherwise
+// the request is scheduled on the task queue (see awaitOrAccept for the
+// synchronous fast path).
+func (s *Server) Query(c *gin.Context) {
+	var req QueryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(400, ErrorResponse{Error: "invalid_request", Code: 400, Message: "Invalid request format"})
+		return
+	}
+
+	if req.Query == "" {
+		c.JSON(400, ErrorResponse{Error: "missing_query", Code: 400, Message: "Query is required"})
+		return
+	}
+
+	cacheKey := fmt.Sprintf("query:%s:%s:%d:%s", cacheScope(c), req.Query, req.TopK, req.RetrievalStrategy)
+
+	ctx, span := middleware.StartSpan(c.Request.Context(), "cache.lookup")
+	cached, found := s.Cache.Get(cacheKey)
+	span.End()
+	if found {
+		c.JSON(200, cached)
+		return
+	}
+
+	claims, _ := auth.FromContext(c)
+	taskID, queue, err := s.Tasks.EnqueueQuery(ctx, tasks.QueryPayload{
+		Query:             req.Query,
+		TopK:              req.TopK,
+		RetrievalStrategy: req.RetrievalStrategy,
+		IncludeSources:    req.IncludeSources,
+		UserContext:       req.UserContext,
+		CallbackURL:       req.CallbackURL,
+	}, tasks.Owner{Sub: claims.Sub, Tenant: claims.Tenant})
+	if err != nil {
+		c.JSON(500, ErrorResponse{Error: "enqueue_failed", Code: 500, Message: "Failed to schedule query"})
+		return
+	}
+
+	s.awaitOrAccept(c, queue, taskID, cacheKey)
+}
@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/auth"
+)
+
+// RegisterRoutes mounts every v1 endpoint onto r. Routes that touch user
+// data require the scope auth.Middleware's Claims must carry; documents
+// reads and writes currently share documents:write since there's no
+// separate read scope yet.
+func RegisterRoutes(r *gin.Engine, s *Server) {
+	r.GET("/health", s.Health)
+	r.POST("/auth/login", s.Login)
+
+	r.POST("/query", auth.RequireScope(auth.ScopeQueryRead), s.Query)
+	r.GET("/documents", auth.RequireScope(auth.ScopeDocumentsWrite), s.Documents)
+	r.POST("/documents/upload", auth.RequireScope(auth.ScopeDocumentsWrite), s.UploadInit)
+	r.PATCH("/documents/upload/:id", auth.RequireScope(auth.ScopeDocumentsWrite), s.UploadChunk)
+	r.PUT("/documents/upload/:id", auth.RequireScope(auth.ScopeDocumentsWrite), s.UploadCommit)
+	r.GET("/models", s.ListModels)
+	r.POST("/generate", auth.RequireScope(auth.ScopeGenerateInvoke), s.Generate)
+	r.POST("/generate/stream", auth.RequireScope(auth.ScopeGenerateInvoke), s.GenerateStream)
+	r.GET("/generate/ws", auth.RequireScope(auth.ScopeGenerateInvoke), s.GenerateWS)
+	r.GET("/tasks/:id", auth.RequireAnyScope(auth.ScopeQueryRead, auth.ScopeGenerateInvoke), s.TaskStatus)
+	r.DELETE("/tasks/:id", auth.RequireAnyScope(auth.ScopeQueryRead, auth.ScopeGenerateInvoke), s.TaskCancel)
+}
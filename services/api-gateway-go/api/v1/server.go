@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/auth"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/cache"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/storage"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/tasks"
+)
+
+// Server holds the dependencies every v1 handler needs. cmd/gateway
+// constructs one at startup and registers its methods as gin routes.
+type Server struct {
+	DB          *gorm.DB
+	Store       storage.Manager
+	Cache       *cache.Cache
+	Tasks       *tasks.Client
+	UploadStore *uploadSessionStore
+
+	AuthSecret string
+	AuthUsers  map[string]auth.UserRecord
+}
+
+// NewServer wires up a Server, ready to have its handlers registered.
+// authSecret and authUsers back the dev /auth/login endpoint; authUsers
+// may be nil if no users file was configured, in which case Login always
+// rejects.
+func NewServer(db *gorm.DB, store storage.Manager, taskClient *tasks.Client, authSecret string, authUsers map[string]auth.UserRecord) *Server {
+	return &Server{
+		DB:          db,
+		Store:       store,
+		Cache:       cache.New("gateway", 5*time.Minute, 10*time.Minute),
+		Tasks:       taskClient,
+		UploadStore: newUploadSessionStore(),
+		AuthSecret:  authSecret,
+		AuthUsers:   authUsers,
+	}
+}
+
+// cacheScope prefixes a cache key with the requesting user's tenant and
+// subject so two different users (or the same user in different
+// tenants) never collide on one cached response. Auth guards every route
+// that caches, so the "anon" fallback is defensive only.
+func cacheScope(c *gin.Context) string {
+	claims, ok := auth.FromContext(c)
+	if !ok {
+		return "anon"
+	}
+	if claims.Tenant != "" {
+		return claims.Tenant + ":" + claims.Sub
+	}
+	return claims.Sub
+}
@@ -0,0 +1,192 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const defaultStreamTimeout = 30 * time.Second
+
+// deadlineTimer enforces a per-connection idle deadline shared by
+// GenerateStream and GenerateWS: every write resets it, and if it fires
+// first it cancels the stream's context, so a stalled upstream call
+// (not just a client disconnect) closes deterministically instead of
+// leaking the handler's goroutine forever.
+type deadlineTimer struct {
+	timer *time.Timer
+}
+
+func newDeadlineTimer(timeout time.Duration, cancel context.CancelFunc) *deadlineTimer {
+	return &deadlineTimer{timer: time.AfterFunc(timeout, cancel)}
+}
+
+func (d *deadlineTimer) reset(timeout time.Duration) {
+	d.timer.Reset(timeout)
+}
+
+func (d *deadlineTimer) stop() {
+	d.timer.Stop()
+}
+
+// streamTimeout parses GenerateRequest.Timeout, falling back to
+// defaultStreamTimeout on an empty or unparseable value.
+func streamTimeout(raw string) time.Duration {
+	if d, err := time.ParseDuration(raw); err == nil {
+		return d
+	}
+	return defaultStreamTimeout
+}
+
+// placeholderTokens stands in for a real LLM's incremental output: a
+// synthetic sentence like the one ProcessGenerateTask returns (worded
+// "streaming" rather than "generated"), split so callers can see it
+// arrive token by token. Swapping in a real streaming LLM
+// client only touches this function.
+func placeholderTokens(prompt string) []string {
+	return strings.Fields(fmt.Sprintf("High-performance Go streaming response for: %s", prompt))
+}
+
+// GenerateStream handles POST /generate/stream: an SSE alternative to
+// Generate that emits "token" events as they're produced, a "usage"
+// event with the running count, then "done". The client's disconnect
+// (c.Request.Context().Done()) and the per-connection deadlineTimer both
+// cancel ctx, which stops token emission (and, with a real LLM, billing)
+// immediately.
+func (s *Server) GenerateStream(c *gin.Context) {
+	var req GenerateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(400, ErrorResponse{Error: "invalid_request", Code: 400, Message: "Invalid request format"})
+		return
+	}
+	if req.Prompt == "" {
+		c.JSON(400, ErrorResponse{Error: "missing_prompt", Code: 400, Message: "Prompt is required"})
+		return
+	}
+
+	flusher, ok := c.Writer.(http.Flusher)
+	if !ok {
+		c.JSON(500, ErrorResponse{Error: "streaming_unsupported", Code: 500, Message: "Response writer does not support streaming"})
+		return
+	}
+
+	timeout := streamTimeout(req.Timeout)
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	deadline := newDeadlineTimer(timeout, cancel)
+	defer deadline.stop()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+
+	start := time.Now()
+	tokensUsed := 0
+	for _, tok := range placeholderTokens(req.Prompt) {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		tokensUsed++
+		c.SSEvent("token", gin.H{"text": tok})
+		flusher.Flush()
+		deadline.reset(timeout)
+	}
+
+	c.SSEvent("usage", gin.H{"tokens_used": tokensUsed})
+	flusher.Flush()
+	deadline.reset(timeout)
+
+	c.SSEvent("done", gin.H{
+		"tokens_used":     tokensUsed,
+		"processing_time": time.Since(start).Seconds(),
+		"status":          "success",
+	})
+	flusher.Flush()
+}
+
+// wsUpgrader has no cookie-based auth to protect against (see
+// middleware.CORS), so it accepts upgrades from any origin.
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// GenerateWS handles GET /generate/ws: the same token/usage/done
+// sequence as GenerateStream, over a duplex WebSocket so the client can
+// send "cancel" mid-stream instead of only severing the connection. The
+// first message the client sends must be the GenerateRequest JSON body.
+func (s *Server) GenerateWS(c *gin.Context) {
+	conn, err := wsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	var req GenerateRequest
+	if err := conn.ReadJSON(&req); err != nil || req.Prompt == "" {
+		conn.WriteJSON(gin.H{"error": "invalid_request", "code": 400, "message": "First message must be a GenerateRequest with a prompt"})
+		return
+	}
+
+	timeout := streamTimeout(req.Timeout)
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	deadline := newDeadlineTimer(timeout, cancel)
+	defer deadline.stop()
+
+	go watchWSControl(conn, cancel)
+
+	start := time.Now()
+	tokensUsed := 0
+	for _, tok := range placeholderTokens(req.Prompt) {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		tokensUsed++
+		if err := conn.WriteJSON(gin.H{"event": "token", "text": tok}); err != nil {
+			return
+		}
+		deadline.reset(timeout)
+	}
+
+	if err := conn.WriteJSON(gin.H{"event": "usage", "tokens_used": tokensUsed}); err != nil {
+		return
+	}
+	deadline.reset(timeout)
+
+	conn.WriteJSON(gin.H{
+		"event":           "done",
+		"tokens_used":     tokensUsed,
+		"processing_time": time.Since(start).Seconds(),
+		"status":          "success",
+	})
+}
+
+// watchWSControl reads client-sent control messages for the lifetime of
+// the connection: "cancel" stops the stream early. Anything else is
+// additional context a real LLM client would fold into the in-flight
+// call; there's nothing upstream to forward it to yet, so it's ignored.
+func watchWSControl(conn *websocket.Conn, cancel context.CancelFunc) {
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if string(msg) == "cancel" {
+			cancel()
+			return
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/auth"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/tasks"
+)
+
+const pollInterval = 100 * time.Millisecond
+
+// awaitOrAccept implements the "?wait=true&timeout=5s" fast path: if
+// requested, it blocks polling the task until it finishes or the
+// deadline elapses, returning the result body directly; otherwise (and
+// whenever the deadline is hit first) it falls back to 202 with a
+// status URL.
+func (s *Server) awaitOrAccept(c *gin.Context, queue, taskID, cacheKey string) {
+	wait := c.Query("wait") == "true"
+	deadline := 5 * time.Second
+	if d, err := time.ParseDuration(c.DefaultQuery("timeout", "5s")); err == nil {
+		deadline = d
+	}
+
+	if wait {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), deadline)
+		defer cancel()
+
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		for {
+			status, err := s.Tasks.Status(queue, taskID)
+			if err == nil {
+				switch status.Status {
+				case "done":
+					s.Cache.Set(cacheKey, status.Result)
+					c.Data(http.StatusOK, "application/json", status.Result)
+					return
+				case "failed":
+					c.JSON(500, ErrorResponse{Error: "task_failed", Code: 500, Message: status.Error})
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				acceptTask(c, queue, taskID)
+				return
+			case <-ticker.C:
+			}
+		}
+	}
+
+	acceptTask(c, queue, taskID)
+}
+
+func acceptTask(c *gin.Context, queue, taskID string) {
+	c.JSON(http.StatusAccepted, gin.H{
+		"task_id":    taskID,
+		"status":     "queued",
+		"status_url": "/tasks/" + taskID + "?queue=" + queue,
+	})
+}
+
+// TaskStatus handles GET /tasks/:id. It 404s on a task belonging to
+// another caller exactly as it would for an unknown ID, so polling can't
+// be used to distinguish "not yours" from "doesn't exist".
+func (s *Server) TaskStatus(c *gin.Context) {
+	taskID := c.Param("id")
+	claims, _ := auth.FromContext(c)
+	if !s.Tasks.Owns(c.Request.Context(), taskID, tasks.Owner{Sub: claims.Sub, Tenant: claims.Tenant}) {
+		c.JSON(404, ErrorResponse{Error: "task_not_found", Code: 404, Message: "No such task"})
+		return
+	}
+
+	queue := c.DefaultQuery("queue", tasks.QueueQuery)
+	status, err := s.Tasks.Status(queue, taskID)
+	if err != nil {
+		c.JSON(404, ErrorResponse{Error: "task_not_found", Code: 404, Message: "No such task"})
+		return
+	}
+	c.JSON(200, status)
+}
+
+// TaskCancel handles DELETE /tasks/:id, with the same ownership check as
+// TaskStatus.
+func (s *Server) TaskCancel(c *gin.Context) {
+	taskID := c.Param("id")
+	claims, _ := auth.FromContext(c)
+	if !s.Tasks.Owns(c.Request.Context(), taskID, tasks.Owner{Sub: claims.Sub, Tenant: claims.Tenant}) {
+		c.JSON(404, ErrorResponse{Error: "task_not_found", Code: 404, Message: "No such task"})
+		return
+	}
+
+	queue := c.DefaultQuery("queue", tasks.QueueQuery)
+	if err := s.Tasks.Cancel(queue, taskID); err != nil {
+		c.JSON(500, ErrorResponse{Error: "cancel_failed", Code: 500, Message: "Failed to cancel task"})
+		return
+	}
+	c.JSON(200, gin.H{"task_id": taskID, "status": "cancelled"})
+}
@@ -0,0 +1,86 @@
+// Package v1 holds the gateway's HTTP handlers and their request/response
+// types. Handlers are methods on *Server so they share one set of
+// dependencies (DB, object storage, cache, task client) instead of
+// reaching for package-level globals.
+package v1
+
+import "time"
+
+type HealthResponse struct {
+	ServiceName string    `json:"service_name"`
+	Status      string    `json:"status"`
+	Timestamp   time.Time `json:"timestamp"`
+	Version     string    `json:"version"`
+}
+
+type QueryRequest struct {
+	Query             string            `json:"query"`
+	TopK              int               `json:"top_k"`
+	RetrievalStrategy string            `json:"retrieval_strategy"`
+	IncludeSources    bool              `json:"include_sources"`
+	UserContext       map[string]string `json:"user_context,omitempty"`
+	CallbackURL       string            `json:"callback_url,omitempty"`
+}
+
+type QueryResponse struct {
+	Query          string   `json:"query"`
+	Response       string   `json:"response"`
+	Sources        []string `json:"sources"`
+	ProcessingTime float64  `json:"processing_time"`
+	Status         string   `json:"status"`
+}
+
+type Document struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Type  string `json:"type"`
+}
+
+type DocumentsResponse struct {
+	Documents []Document `json:"documents"`
+	Total     int        `json:"total"`
+	Status    string     `json:"status"`
+}
+
+type Model struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Status string `json:"status"`
+}
+
+type ModelsResponse struct {
+	Models []Model `json:"models"`
+	Total  int     `json:"total"`
+	Status string  `json:"status"`
+}
+
+type UploadResponse struct {
+	Message        string  `json:"message"`
+	DocumentID     string  `json:"document_id"`
+	Status         string  `json:"status"`
+	ProcessingTime float64 `json:"processing_time"`
+}
+
+type GenerateRequest struct {
+	Prompt      string `json:"prompt"`
+	CallbackURL string `json:"callback_url,omitempty"`
+	// Timeout bounds /generate/stream and /generate/ws: a parseable
+	// duration (e.g. "30s") after which an idle stream is torn down.
+	// Ignored by the synchronous /generate.
+	Timeout string `json:"timeout,omitempty"`
+}
+
+type GenerateResponse struct {
+	Prompt         string  `json:"prompt"`
+	Response       string  `json:"response"`
+	Model          string  `json:"model"`
+	TokensUsed     int     `json:"tokens_used"`
+	ProcessingTime float64 `json:"processing_time"`
+	Status         string  `json:"status"`
+}
+
+type ErrorResponse struct {
+	Error   string `json:"error"`
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
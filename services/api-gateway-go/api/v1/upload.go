@@ -0,0 +1,222 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/auth"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/middleware"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/models"
+)
+
+// uploadSession tracks one in-flight resumable upload, Docker-registry
+// blob-upload style: a client opens a session, PATCHes sequential byte
+// ranges, then commits with the expected digest.
+type uploadSession struct {
+	mu          sync.Mutex
+	tmpPath     string
+	file        *os.File
+	hasher      hash.Hash
+	offset      int64
+	contentType string
+}
+
+// uploadSessionStore holds sessions in flight for the lifetime of the
+// process; a restart drops any unfinished upload, same as before.
+type uploadSessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]*uploadSession
+}
+
+func newUploadSessionStore() *uploadSessionStore {
+	return &uploadSessionStore{sessions: make(map[string]*uploadSession)}
+}
+
+func (s *uploadSessionStore) put(id string, session *uploadSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[id] = session
+}
+
+func (s *uploadSessionStore) get(id string) (*uploadSession, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[id]
+	return session, ok
+}
+
+func (s *uploadSessionStore) take(id string) (*uploadSession, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[id]
+	if ok {
+		delete(s.sessions, id)
+	}
+	return session, ok
+}
+
+// UploadInit opens a resumable upload session and tells the client where
+// to PATCH chunks (POST /documents/upload).
+func (s *Server) UploadInit(c *gin.Context) {
+	id := fmt.Sprintf("upload-%d", time.Now().UnixNano())
+
+	tmp, err := os.CreateTemp("", "upload-"+id+"-")
+	if err != nil {
+		c.JSON(500, ErrorResponse{Error: "upload_init_failed", Code: 500, Message: "Could not open upload session"})
+		return
+	}
+
+	s.UploadStore.put(id, &uploadSession{
+		tmpPath:     tmp.Name(),
+		file:        tmp,
+		hasher:      sha256.New(),
+		contentType: c.GetHeader("Content-Type"),
+	})
+
+	location := "/documents/upload/" + id
+	c.Header("Location", location)
+	c.Header("Upload-UUID", id)
+	c.JSON(http.StatusAccepted, gin.H{
+		"upload_uuid": id,
+		"location":    location,
+		"status":      "session_opened",
+	})
+}
+
+// UploadChunk appends one Content-Range-addressed chunk to the session's
+// temp object (PATCH /documents/upload/:id).
+func (s *Server) UploadChunk(c *gin.Context) {
+	session, ok := s.UploadStore.get(c.Param("id"))
+	if !ok {
+		c.JSON(404, ErrorResponse{Error: "upload_not_found", Code: 404, Message: "No such upload session"})
+		return
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	start, _, err := parseContentRange(c.GetHeader("Content-Range"), session.offset)
+	if err != nil {
+		c.JSON(http.StatusRequestedRangeNotSatisfiable, ErrorResponse{Error: "invalid_content_range", Code: 416, Message: err.Error()})
+		return
+	}
+	if start != session.offset {
+		c.JSON(http.StatusRequestedRangeNotSatisfiable, ErrorResponse{
+			Error: "out_of_order_chunk", Code: 416,
+			Message: fmt.Sprintf("expected chunk starting at %d, got %d", session.offset, start),
+		})
+		return
+	}
+
+	written, err := io.Copy(io.MultiWriter(session.file, session.hasher), c.Request.Body)
+	if err != nil {
+		c.JSON(500, ErrorResponse{Error: "chunk_write_failed", Code: 500, Message: "Failed to persist chunk"})
+		return
+	}
+	session.offset += written
+
+	c.Header("Range", fmt.Sprintf("0-%d", session.offset-1))
+	c.Header("Location", "/documents/upload/"+c.Param("id"))
+	c.Status(http.StatusAccepted)
+}
+
+// UploadCommit verifies the accumulated SHA-256 digest, pushes the
+// finished object to the configured storage.Manager, and records its
+// metadata (PUT /documents/upload/:id?digest=sha256:...).
+func (s *Server) UploadCommit(c *gin.Context) {
+	session, ok := s.UploadStore.take(c.Param("id"))
+	if !ok {
+		c.JSON(404, ErrorResponse{Error: "upload_not_found", Code: 404, Message: "No such upload session"})
+		return
+	}
+	defer os.Remove(session.tmpPath)
+
+	expected := strings.TrimPrefix(c.Query("digest"), "sha256:")
+	computed := fmt.Sprintf("%x", session.hasher.Sum(nil))
+	if expected != "" && expected != computed {
+		c.JSON(400, ErrorResponse{Error: "digest_mismatch", Code: 400, Message: "Uploaded bytes do not match the expected digest"})
+		return
+	}
+
+	if err := session.file.Close(); err != nil {
+		c.JSON(500, ErrorResponse{Error: "upload_commit_failed", Code: 500, Message: "Failed to finalize upload"})
+		return
+	}
+	f, err := os.Open(session.tmpPath)
+	if err != nil {
+		c.JSON(500, ErrorResponse{Error: "upload_commit_failed", Code: 500, Message: "Failed to read staged upload"})
+		return
+	}
+	defer f.Close()
+
+	start := time.Now()
+	documentID := fmt.Sprintf("go_doc_%d", time.Now().UnixNano())
+	storageKey := "documents/" + documentID
+
+	ctx, span := middleware.StartSpan(c.Request.Context(), "storage.put")
+	err = s.Store.Put(ctx, storageKey, f, session.offset, session.contentType)
+	span.End()
+	if err != nil {
+		c.JSON(500, ErrorResponse{Error: "storage_error", Code: 500, Message: "Failed to persist document"})
+		return
+	}
+
+	claims, _ := auth.FromContext(c)
+	doc := models.Document{
+		DocumentID: documentID,
+		OwnerSub:   claims.Sub,
+		Tenant:     claims.Tenant,
+		Title:      c.Query("title"),
+		MIME:       session.contentType,
+		SHA256:     computed,
+		Size:       session.offset,
+		StorageKey: storageKey,
+		UploadedAt: time.Now(),
+	}
+	if err := s.DB.WithContext(ctx).Create(&doc).Error; err != nil {
+		c.JSON(500, ErrorResponse{Error: "db_error", Code: 500, Message: "Failed to record document metadata"})
+		return
+	}
+
+	c.JSON(201, UploadResponse{
+		Message:        "Document uploaded successfully to Go API Gateway",
+		DocumentID:     documentID,
+		Status:         "uploaded",
+		ProcessingTime: time.Since(start).Seconds(),
+	})
+}
+
+// parseContentRange parses a "bytes start-end/total" header. An empty
+// header is treated as a single chunk starting at defaultStart, so
+// simple one-shot clients don't have to set it.
+func parseContentRange(header string, defaultStart int64) (start, end int64, err error) {
+	if header == "" {
+		return defaultStart, -1, nil
+	}
+	header = strings.TrimPrefix(header, "bytes ")
+	parts := strings.SplitN(header, "/", 2)
+	rangeParts := strings.SplitN(parts[0], "-", 2)
+	if len(rangeParts) != 2 {
+		return 0, 0, errors.New("malformed Content-Range header")
+	}
+	start, err = strconv.ParseInt(rangeParts[0], 10, 64)
+	if err != nil {
+		return 0, 0, errors.New("malformed Content-Range start")
+	}
+	end, err = strconv.ParseInt(rangeParts[1], 10, 64)
+	if err != nil {
+		return 0, 0, errors.New("malformed Content-Range end")
+	}
+	return start, end, nil
+}
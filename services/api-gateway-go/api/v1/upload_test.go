@@ -0,0 +1,38 @@
+package v1
+
+import "testing"
+
+func TestParseContentRange(t *testing.T) {
+	cases := []struct {
+		name         string
+		header       string
+		defaultStart int64
+		wantStart    int64
+		wantEnd      int64
+		wantErr      bool
+	}{
+		{name: "empty header uses defaultStart", header: "", defaultStart: 42, wantStart: 42, wantEnd: -1},
+		{name: "well-formed range", header: "bytes 0-1023/4096", wantStart: 0, wantEnd: 1023},
+		{name: "missing dash", header: "bytes 1023/4096", wantErr: true},
+		{name: "non-numeric start", header: "bytes x-1023/4096", wantErr: true},
+		{name: "non-numeric end", header: "bytes 0-x/4096", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, err := parseContentRange(tc.header, tc.defaultStart)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("parseContentRange(%q): want error, got nil", tc.header)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseContentRange(%q): unexpected error: %v", tc.header, err)
+			}
+			if start != tc.wantStart || end != tc.wantEnd {
+				t.Fatalf("parseContentRange(%q) = (%d, %d), want (%d, %d)", tc.header, start, end, tc.wantStart, tc.wantEnd)
+			}
+		})
+	}
+}
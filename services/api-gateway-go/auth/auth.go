@@ -0,0 +1,45 @@
+// Package auth validates bearer tokens on incoming requests and carries
+// the resulting claims to handlers via the gin context, so every
+// handler that needs to scope data per-user reads the same Claims type.
+package auth
+
+// Claims is what every validated request carries: who the caller is,
+// what scopes their token grants, and which tenant they belong to.
+type Claims struct {
+	Sub    string   `json:"sub"`
+	Scopes []string `json:"scopes"`
+	Tenant string   `json:"tenant,omitempty"`
+}
+
+// GetSub satisfies the duck-typed interface ratelimit.KeyByUser reads off
+// the gin context, so a rate-limit rule with key: user keys on the same
+// identity Middleware attaches.
+func (c Claims) GetSub() string {
+	return c.Sub
+}
+
+// HasScope reports whether scope is among the token's granted scopes.
+func (c Claims) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Validator verifies a bearer token string and extracts its Claims.
+type Validator interface {
+	Validate(token string) (Claims, error)
+}
+
+// Scopes the gateway's routes can require.
+const (
+	ScopeQueryRead      = "query:read"
+	ScopeDocumentsWrite = "documents:write"
+	ScopeGenerateInvoke = "generate:invoke"
+	// ScopeAdminMetrics is reserved for future admin-only endpoints.
+	// /metrics itself stays on the auth allowlist so Prometheus can
+	// scrape it without a token, matching standard exporter practice.
+	ScopeAdminMetrics = "admin:metrics"
+)
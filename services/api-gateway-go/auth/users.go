@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// UserRecord is one entry in the static users file backing the dev
+// /auth/login endpoint. It exists to hand out real tokens for local
+// testing; production deployments should point AUTH_JWKS_URL at a real
+// identity provider instead.
+type UserRecord struct {
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+	Sub      string   `json:"sub"`
+	Scopes   []string `json:"scopes"`
+	Tenant   string   `json:"tenant,omitempty"`
+}
+
+// LoadUsersFile reads a JSON array of UserRecord from path, keyed by
+// username for Login's lookup.
+func LoadUsersFile(path string) (map[string]UserRecord, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("auth: read users file: %w", err)
+	}
+
+	var records []UserRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("auth: parse users file: %w", err)
+	}
+
+	users := make(map[string]UserRecord, len(records))
+	for _, r := range records {
+		users[r.Username] = r
+	}
+	return users, nil
+}
@@ -0,0 +1,59 @@
+// Package cache wraps an in-process TTL cache with named Prometheus
+// hit/miss counters, so every call site gets cache_hits_total /
+// cache_misses_total{cache="..."} for free instead of reimplementing it.
+package cache
+
+import (
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	hitsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_hits_total",
+		Help: "Number of cache lookups that found a value.",
+	}, []string{"cache"})
+	missesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_misses_total",
+		Help: "Number of cache lookups that found nothing.",
+	}, []string{"cache"})
+)
+
+func init() {
+	prometheus.MustRegister(hitsTotal, missesTotal)
+}
+
+// Cache is a named, metrics-instrumented TTL cache.
+type Cache struct {
+	name  string
+	store *gocache.Cache
+}
+
+// New creates a Cache identified by name (used as the Prometheus
+// "cache" label) with the given default expiration and cleanup interval.
+func New(name string, defaultExpiration, cleanupInterval time.Duration) *Cache {
+	return &Cache{name: name, store: gocache.New(defaultExpiration, cleanupInterval)}
+}
+
+// Get records a hit/miss and returns the cached value, if any.
+func (c *Cache) Get(key string) (interface{}, bool) {
+	v, found := c.store.Get(key)
+	if found {
+		hitsTotal.WithLabelValues(c.name).Inc()
+	} else {
+		missesTotal.WithLabelValues(c.name).Inc()
+	}
+	return v, found
+}
+
+// Set stores value under key using this Cache's default expiration.
+func (c *Cache) Set(key string, value interface{}) {
+	c.store.Set(key, value, gocache.DefaultExpiration)
+}
+
+// Items exposes the raw cache contents, e.g. for debug endpoints.
+func (c *Cache) Items() map[string]gocache.Item {
+	return c.store.Items()
+}
@@ -0,0 +1,105 @@
+// Command gateway runs the RAG API Gateway, either as the HTTP server
+// ("serve", the default) or as a task-queue worker ("worker").
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/urfave/cli/v2"
+
+	v1 "github.com/snosachyev/ai_social_helper/services/api-gateway-go/api/v1"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/auth"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/config"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/middleware"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/models"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/storage"
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/tasks"
+)
+
+const shutdownGrace = 5 * time.Second
+
+func main() {
+	app := &cli.App{
+		Name:  "gateway",
+		Usage: "RAG API Gateway",
+		Commands: []*cli.Command{
+			{Name: "serve", Usage: "run the HTTP gateway (default)", Action: serve},
+			{Name: "worker", Usage: "run an asynq task-queue worker", Action: worker},
+		},
+		Action: serve, // `gateway` with no subcommand behaves like `gateway serve`
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func serve(*cli.Context) error {
+	cfg := config.Load()
+	ctx := context.Background()
+
+	shutdownTracing := initTracing(ctx, cfg.OTLPEndpoint)
+	defer shutdownTracing()
+
+	db, err := models.Connect(cfg.DatabaseURL)
+	if err != nil {
+		return err
+	}
+
+	objectStore, err := storage.NewManager()
+	if err != nil {
+		return err
+	}
+
+	taskClient := tasks.NewClient(cfg.AsynqRedisAddr)
+	defer taskClient.Close()
+
+	authUsers, err := auth.LoadUsersFile(cfg.AuthUsersFile)
+	if err != nil {
+		log.Printf("auth: could not load %s, /auth/login will reject all logins: %v", cfg.AuthUsersFile, err)
+	}
+
+	server := v1.NewServer(db, objectStore, taskClient, cfg.AuthJWTSecret, authUsers)
+
+	gin.SetMode(gin.ReleaseMode)
+	r := gin.New()
+	r.Use(gin.Recovery())
+	r.Use(middleware.CORS())
+	r.Use(middleware.Tracing())
+	r.Use(middleware.RequestID())
+	r.Use(middleware.Auth())
+	r.Use(middleware.RateLimit())
+	r.Use(middleware.Metrics())
+
+	v1.RegisterRoutes(r, server)
+	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	log.Printf("🚀 Starting RAG API Gateway on port %s", cfg.Port)
+	log.Printf("📊 High-performance Go API Gateway ready for 1000+ users")
+	return r.Run(":" + cfg.Port)
+}
+
+func worker(*cli.Context) error {
+	cfg := config.Load()
+
+	srv := asynq.NewServer(
+		asynq.RedisClientOpt{Addr: cfg.AsynqRedisAddr},
+		asynq.Config{
+			Concurrency: cfg.WorkerConcurrency,
+			Queues: map[string]int{
+				tasks.QueueQuery:    6,
+				tasks.QueueGenerate: 3,
+				"default":           1,
+			},
+		},
+	)
+
+	log.Printf("🚀 Starting RAG API Gateway worker")
+	return srv.Run(tasks.NewMux())
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+)
+
+// initTracing wires up the global OTel tracer provider. With no
+// OTLP endpoint configured it installs nothing and leaves otel's default
+// no-op provider in place, so middleware.Tracing spans are free to create
+// but go nowhere.
+func initTracing(ctx context.Context, otlpEndpoint string) func() {
+	if otlpEndpoint == "" {
+		return func() {}
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpoint(otlpEndpoint), otlptracehttp.WithInsecure())
+	if err != nil {
+		log.Printf("tracing: failed to start OTLP exporter, spans will not be exported: %v", err)
+		return func() {}
+	}
+
+	res, _ := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceNameKey.String("api-gateway-go"),
+	))
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownGrace)
+		defer cancel()
+		if err := provider.Shutdown(shutdownCtx); err != nil {
+			log.Printf("tracing: shutdown error: %v", err)
+		}
+	}
+}
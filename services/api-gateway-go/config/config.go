@@ -0,0 +1,65 @@
+// Package config centralizes the gateway's environment-derived settings
+// so cmd/gateway doesn't have to scatter os.Getenv calls across startup.
+package config
+
+import (
+	"os"
+	"strconv"
+)
+
+// Config holds everything cmd/gateway needs to wire up the server or
+// worker process. Individual packages (storage, ratelimit) still read
+// their own narrower env vars directly where that keeps them
+// self-contained; Config covers the settings cmd/gateway itself acts on.
+type Config struct {
+	Port              string
+	DatabaseURL       string
+	AsynqRedisAddr    string
+	WorkerConcurrency int
+	GatewaySelfAddr   string
+	GatewayPeers      string
+	OTLPEndpoint      string
+	AuthJWTSecret     string
+	AuthUsersFile     string
+}
+
+// Load reads Config from the environment, applying the same defaults the
+// gateway has always used.
+func Load() Config {
+	return Config{
+		Port:              getenv("PORT", "8000"),
+		DatabaseURL:       getenv("DATABASE_URL", "host=localhost user=postgres password=postgres dbname=ai_social_helper sslmode=disable"),
+		AsynqRedisAddr:    firstNonEmpty(os.Getenv("ASYNQ_REDIS_ADDR"), os.Getenv("REDIS_ADDR"), "localhost:6379"),
+		WorkerConcurrency: getenvInt("WORKER_CONCURRENCY", 10),
+		GatewaySelfAddr:   os.Getenv("GATEWAY_SELF_ADDR"),
+		GatewayPeers:      os.Getenv("GATEWAY_PEERS"),
+		OTLPEndpoint:      os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"),
+		AuthJWTSecret:     getenv("AUTH_JWT_SECRET", "dev-secret"),
+		AuthUsersFile:     getenv("AUTH_USERS_FILE", "config/users.json"),
+	}
+}
+
+func getenv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getenvInt(key string, fallback int) int {
+	if v := os.Getenv(key); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			return n
+		}
+	}
+	return fallback
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"log"
+	"os"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/auth"
+)
+
+// authSkipPaths lists routes Auth lets through without a token: health
+// checks, the Prometheus scrape endpoint, and the login endpoint itself.
+var authSkipPaths = map[string]bool{
+	"/health":     true,
+	"/metrics":    true,
+	"/auth/login": true,
+}
+
+// Auth builds the gateway's JWT auth middleware from environment
+// configuration: AUTH_JWKS_URL selects RS256 validation against a JWKS
+// endpoint (auto-refreshed); otherwise AUTH_JWT_SECRET (default
+// "dev-secret") is used for HS256, matching what /auth/login signs with.
+// Run this before RateLimit so a "key: user" rule can read the claims it
+// attaches.
+func Auth() gin.HandlerFunc {
+	return auth.Middleware(newValidator(), authSkipPaths)
+}
+
+func newValidator() auth.Validator {
+	if url := os.Getenv("AUTH_JWKS_URL"); url != "" {
+		return auth.NewJWKSValidator(url)
+	}
+	secret := os.Getenv("AUTH_JWT_SECRET")
+	if secret == "" {
+		secret = "dev-secret"
+		log.Printf("auth: AUTH_JWT_SECRET not set, using insecure development default")
+	}
+	return auth.NewHS256Validator(secret)
+}
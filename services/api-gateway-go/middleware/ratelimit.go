@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"log"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/snosachyev/ai_social_helper/services/api-gateway-go/ratelimit"
+)
+
+// RateLimit builds the gateway's rate-limit middleware from environment
+// configuration: RATE_LIMIT_BACKEND selects memory (default) or redis,
+// RATE_LIMIT_CONFIG points at the per-route rule file, and GATEWAY_PEERS
+// turns on rendezvous-hashed global mode.
+func RateLimit() gin.HandlerFunc {
+	return ratelimit.Middleware(newRateLimitBackend(), loadRateLimitRules(), newPeerTable())
+}
+
+func newRateLimitBackend() ratelimit.Backend {
+	if os.Getenv("RATE_LIMIT_BACKEND") == "redis" {
+		addr := os.Getenv("REDIS_ADDR")
+		if addr == "" {
+			addr = "localhost:6379"
+		}
+		client := redis.NewClient(&redis.Options{Addr: addr})
+		return ratelimit.NewRedisBackend(client)
+	}
+	return ratelimit.NewMemoryBackend()
+}
+
+func loadRateLimitRules() []ratelimit.Rule {
+	path := os.Getenv("RATE_LIMIT_CONFIG")
+	if path == "" {
+		path = "config/ratelimit.yaml"
+	}
+	rules, err := ratelimit.LoadRules(path)
+	if err != nil {
+		log.Printf("ratelimit: could not load %s, using defaults: %v", path, err)
+		return nil
+	}
+	return rules
+}
+
+func newPeerTable() *ratelimit.PeerTable {
+	peersEnv := os.Getenv("GATEWAY_PEERS")
+	if peersEnv == "" {
+		return nil
+	}
+	return &ratelimit.PeerTable{
+		Self:  os.Getenv("GATEWAY_SELF_ADDR"),
+		Peers: strings.Split(peersEnv, ","),
+	}
+}
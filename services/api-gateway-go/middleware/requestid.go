@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestID assigns (or preserves) a unique ID for the request, stashing
+// it in the context under "request_id" for handlers and downstream
+// middleware (ratelimit's KeyByRequestID) to read. When Tracing ran
+// first and opened a span, its trace ID is reused as the request ID so
+// logs, headers, and traces all key off the same value.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader("X-Request-ID")
+		if requestID == "" {
+			requestID = TraceID(c.Request.Context())
+		}
+		if requestID == "" {
+			requestID = fmt.Sprintf("req-%d", time.Now().UnixNano())
+		}
+		c.Set("request_id", requestID)
+		c.Header("X-Request-ID", requestID)
+		c.Next()
+	}
+}
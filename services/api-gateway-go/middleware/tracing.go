@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("api-gateway-go")
+
+// Tracing starts a span for each request, named "<method> <route>", and
+// attaches it to the request context so handlers and downstream calls
+// (cache lookups, storage/task calls) can open child spans via
+// otel.Tracer("api-gateway-go").Start(ctx, "..."). Run this before
+// RequestID so its request ID can fall back to the span's trace ID.
+func Tracing() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, span := tracer.Start(c.Request.Context(), c.Request.Method+" "+c.FullPath())
+		defer span.End()
+
+		span.SetAttributes(
+			attribute.String("http.method", c.Request.Method),
+			attribute.String("http.route", c.FullPath()),
+		)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		span.SetAttributes(attribute.Int("http.status_code", c.Writer.Status()))
+	}
+}
+
+// StartSpan opens a child span for work inside a handler (a cache
+// lookup, a storage or task-queue call) so it shows up nested under the
+// request span Tracing opened. Callers must End() the returned span.
+func StartSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name)
+}
+
+// TraceID returns the hex trace ID of the span active on ctx, or "" if
+// ctx carries no span context with a valid trace ID (as with the default
+// no-op tracer provider).
+func TraceID(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
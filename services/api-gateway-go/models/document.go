@@ -0,0 +1,39 @@
+// Package models holds the GORM-backed persistence types shared by the
+// gateway's handlers.
+package models
+
+import (
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Document is the metadata recorded for each object committed through the
+// resumable upload pipeline. The object bytes themselves live in the
+// configured storage.Manager under StorageKey.
+type Document struct {
+	ID         uint      `gorm:"primarykey" json:"-"`
+	DocumentID string    `gorm:"uniqueIndex;size:64" json:"document_id"`
+	OwnerSub   string    `gorm:"index;size:128" json:"-"`
+	Tenant     string    `gorm:"index;size:128" json:"-"`
+	Title      string    `json:"title"`
+	MIME       string    `json:"mime"`
+	SHA256     string    `gorm:"size:64" json:"sha256"`
+	Size       int64     `json:"size"`
+	StorageKey string    `json:"storage_key"`
+	UploadedAt time.Time `json:"uploaded_at"`
+}
+
+// Connect opens a Postgres connection via dsn and migrates the Document
+// table, returning a ready-to-use *gorm.DB.
+func Connect(dsn string) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.AutoMigrate(&Document{}); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
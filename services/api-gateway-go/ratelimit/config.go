@@ -0,0 +1,75 @@
+package ratelimit
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ruleConfig mirrors Rule but with YAML-friendly scalar fields; KeyFunc is
+// resolved from Key via keyFuncsByName once loaded.
+type ruleConfig struct {
+	Name      string `yaml:"name"`
+	Path      string `yaml:"path"`
+	Algorithm string `yaml:"algorithm"`
+	Limit     int    `yaml:"limit"`
+	DurationS string `yaml:"duration"`
+	Burst     int    `yaml:"burst"`
+	Key       string `yaml:"key"` // "ip", "request_id", or "user"
+}
+
+type fileConfig struct {
+	Rules []ruleConfig `yaml:"rules"`
+}
+
+var keyFuncsByName = map[string]KeyFunc{
+	"ip":         KeyByIP,
+	"request_id": KeyByRequestID,
+	"user":       KeyByUser,
+}
+
+// LoadRules reads per-endpoint rate-limit rules from a YAML file, e.g.:
+//
+//	rules:
+//	  - name: generate
+//	    path: /generate
+//	    algorithm: token_bucket
+//	    limit: 5
+//	    duration: 1s
+//	    burst: 2
+//	    key: user
+func LoadRules(path string) ([]Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("ratelimit: read config: %w", err)
+	}
+
+	var cfg fileConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("ratelimit: parse config: %w", err)
+	}
+
+	rules := make([]Rule, 0, len(cfg.Rules))
+	for _, rc := range cfg.Rules {
+		duration, err := time.ParseDuration(rc.DurationS)
+		if err != nil {
+			return nil, fmt.Errorf("ratelimit: rule %q: invalid duration %q: %w", rc.Name, rc.DurationS, err)
+		}
+		keyFunc, ok := keyFuncsByName[rc.Key]
+		if !ok {
+			return nil, fmt.Errorf("ratelimit: rule %q: unknown key %q", rc.Name, rc.Key)
+		}
+		rules = append(rules, Rule{
+			Name:      rc.Name,
+			Path:      rc.Path,
+			Algorithm: Algorithm(rc.Algorithm),
+			Limit:     rc.Limit,
+			Duration:  duration,
+			Burst:     rc.Burst,
+			KeyFunc:   keyFunc,
+		})
+	}
+	return rules, nil
+}
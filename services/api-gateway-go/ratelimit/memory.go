@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryBackend is an in-process Backend backed by a map of bucket state.
+// It is correct for a single replica only; use RedisBackend once the
+// gateway runs behind more than one node.
+type MemoryBackend struct {
+	mu      sync.Mutex
+	buckets map[string]*bucketState
+}
+
+type bucketState struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// NewMemoryBackend constructs an empty MemoryBackend.
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{buckets: make(map[string]*bucketState)}
+}
+
+func (b *MemoryBackend) GetRateLimit(_ context.Context, key string, algo Algorithm, limit int, duration time.Duration, burst int) (Result, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	capacity := float64(limit + burst)
+	refillPerSec := float64(limit) / duration.Seconds()
+
+	now := time.Now()
+	state, ok := b.buckets[key]
+	if !ok {
+		state = &bucketState{tokens: capacity, lastRefill: now}
+		b.buckets[key] = state
+	}
+
+	// Both algorithms are modeled as a token bucket here: token bucket
+	// allows Burst extra tokens up front, leaky bucket caps capacity at
+	// Limit (no burst headroom) and drains at a constant rate.
+	if algo == LeakyBucket {
+		capacity = float64(limit)
+	}
+
+	elapsed := now.Sub(state.lastRefill).Seconds()
+	state.tokens += elapsed * refillPerSec
+	if state.tokens > capacity {
+		state.tokens = capacity
+	}
+	state.lastRefill = now
+
+	resetAt := now.Add(time.Duration((capacity - state.tokens) / refillPerSec * float64(time.Second)))
+
+	if state.tokens < 1 {
+		return Result{Remaining: 0, ResetAt: resetAt, Status: StatusOverLimit}, nil
+	}
+
+	state.tokens--
+	return Result{Remaining: int(state.tokens), ResetAt: resetAt, Status: StatusOK}, nil
+}
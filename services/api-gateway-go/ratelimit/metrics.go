@@ -0,0 +1,12 @@
+package ratelimit
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var rejectedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "ratelimit_rejected_total",
+	Help: "Number of requests rejected for exceeding a rate-limit rule, by rule name.",
+}, []string{"rule"})
+
+func init() {
+	prometheus.MustRegister(rejectedTotal)
+}
@@ -0,0 +1,113 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultRule is the wildcard fallback used when rules contains no entry
+// with Path: "*"; it preserves the previous behavior of a single global
+// per-IP budget so an operator who configures no rules at all still gets
+// one.
+var defaultRule = Rule{
+	Name:      "default",
+	Path:      "*",
+	Algorithm: TokenBucket,
+	Limit:     100,
+	Duration:  time.Second,
+	KeyFunc:   KeyByIP,
+}
+
+// Middleware builds a gin.HandlerFunc that enforces rules against backend.
+// When peers is non-nil and this node does not own the hash slot for a
+// request's key, the request is forwarded to the owning peer instead of
+// being evaluated locally, so a logical counter is shared across replicas
+// without every replica hitting the backend for every key.
+func Middleware(backend Backend, rules []Rule, peers *PeerTable) gin.HandlerFunc {
+	byPath := make(map[string]Rule, len(rules))
+	wildcard := defaultRule
+	for _, r := range rules {
+		if r.Path == "*" {
+			wildcard = r
+			continue
+		}
+		byPath[r.Path] = r
+	}
+
+	return func(c *gin.Context) {
+		rule, ok := byPath[c.FullPath()]
+		if !ok {
+			rule = wildcard
+		}
+
+		key := rule.KeyFunc(c)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		if peers != nil && c.GetHeader(forwardedHeader) == "" {
+			if owner, self := peers.Owner(key); !self {
+				forward(c, owner)
+				return
+			}
+		}
+
+		result, err := backend.GetRateLimit(c.Request.Context(), rule.Name+":"+key, rule.Algorithm, rule.Limit, rule.Duration, rule.Burst)
+		if err != nil {
+			// Fail open: a broken rate-limit backend should not take down
+			// the whole gateway.
+			c.Next()
+			return
+		}
+
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("X-RateLimit-Reset", strconv.FormatInt(result.ResetAt.Unix(), 10))
+
+		if result.Status == StatusOverLimit {
+			rejectedTotal.WithLabelValues(rule.Name).Inc()
+
+			retryAfter := int(time.Until(result.ResetAt).Seconds())
+			if retryAfter < 0 {
+				retryAfter = 0
+			}
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"error":   "rate_limit_exceeded",
+				"code":    http.StatusTooManyRequests,
+				"message": "Too many requests, please try again later",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// forwardedHeader marks a request that has already been proxied once by
+// forward. Middleware checks it before forwarding again, so a
+// misconfigured peer list (e.g. Self missing from it on every node) can't
+// turn into requests bouncing between replicas forever — at most one hop,
+// then the receiving node evaluates the rule locally regardless of what
+// Owner says.
+const forwardedHeader = "X-Gateway-Forwarded-For-Ratelimit"
+
+// forward proxies the request to the peer that owns this key's hash slot
+// so all replicas agree on a single counter for "global mode" rules.
+func forward(c *gin.Context, peer string) {
+	target, err := url.Parse(peer)
+	if err != nil {
+		c.Next()
+		return
+	}
+	c.Request.Header.Set(forwardedHeader, "1")
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	proxy.ServeHTTP(c.Writer, c.Request)
+	c.Abort()
+}
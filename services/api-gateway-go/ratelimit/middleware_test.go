@@ -0,0 +1,45 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMiddlewareAppliesWildcardRuleOverDefault(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	wildcard := Rule{
+		Name:      "global",
+		Path:      "*",
+		Algorithm: TokenBucket,
+		Limit:     1,
+		Duration:  time.Minute,
+		KeyFunc:   KeyByIP,
+	}
+
+	r := gin.New()
+	r.Use(Middleware(NewMemoryBackend(), []Rule{wildcard}, nil))
+	r.GET("/anything", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/anything", nil)
+
+	first := httptest.NewRecorder()
+	r.ServeHTTP(first, req)
+	if first.Code != http.StatusOK {
+		t.Fatalf("first request: want 200, got %d", first.Code)
+	}
+
+	// The configured wildcard rule allows only 1 request/minute; the
+	// hardcoded defaultRule allows 100/second, so a second request
+	// failing here proves the "*" rule from Rules was actually picked up
+	// instead of being silently ignored.
+	second := httptest.NewRecorder()
+	r.ServeHTTP(second, req)
+	if second.Code != http.StatusTooManyRequests {
+		t.Fatalf("second request: want 429 under the configured wildcard rule, got %d", second.Code)
+	}
+}
@@ -0,0 +1,86 @@
+// Package ratelimit implements pluggable, backend-agnostic rate limiting.
+//
+// A Rule describes a budget (Limit requests per Duration, with an optional
+// Burst) enforced under a chosen Algorithm and keyed by a caller-supplied
+// KeyFunc (IP, request ID, authenticated user, ...). Rules are evaluated
+// against a Backend, which owns the actual counters; MemoryBackend keeps
+// them in-process while RedisBackend shares them across gateway replicas.
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Algorithm selects how a Backend tracks and consumes a Rule's budget.
+type Algorithm string
+
+const (
+	TokenBucket Algorithm = "token_bucket"
+	LeakyBucket Algorithm = "leaky_bucket"
+)
+
+// Status is the outcome of evaluating a single request against a Rule.
+type Status string
+
+const (
+	StatusOK        Status = "OK"
+	StatusOverLimit Status = "OVER_LIMIT"
+)
+
+// Result is what a Backend returns for a single GetRateLimit call.
+type Result struct {
+	Remaining int
+	ResetAt   time.Time
+	Status    Status
+}
+
+// KeyFunc extracts the rate-limit key (IP, request ID, user ID, ...) from
+// a request. Returning "" disables the rule for that request.
+type KeyFunc func(c *gin.Context) string
+
+// Rule binds a name and algorithm/budget to the route(s) it protects.
+type Rule struct {
+	Name      string
+	Path      string // route pattern, e.g. "/generate"; "*" matches everything
+	Algorithm Algorithm
+	Limit     int
+	Duration  time.Duration
+	Burst     int
+	KeyFunc   KeyFunc
+}
+
+// Backend tracks rate-limit state for a (key, rule) pair. Implementations
+// must be safe to call concurrently and, for distributed backends, across
+// processes sharing the same storage.
+type Backend interface {
+	GetRateLimit(ctx context.Context, key string, algo Algorithm, limit int, duration time.Duration, burst int) (Result, error)
+}
+
+// KeyByIP keys on the client's source IP.
+func KeyByIP(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+// KeyByRequestID keys on the inbound/generated X-Request-ID.
+func KeyByRequestID(c *gin.Context) string {
+	if v, ok := c.Get("request_id"); ok {
+		if s, ok := v.(string); ok {
+			return "req:" + s
+		}
+	}
+	return "req:" + c.GetHeader("X-Request-ID")
+}
+
+// KeyByUser keys on the authenticated user (populated by the auth
+// middleware). Falls back to the client IP for unauthenticated requests.
+func KeyByUser(c *gin.Context) string {
+	if v, ok := c.Get("user"); ok {
+		if sub, ok := v.(interface{ GetSub() string }); ok && sub.GetSub() != "" {
+			return "user:" + sub.GetSub()
+		}
+	}
+	return KeyByIP(c)
+}
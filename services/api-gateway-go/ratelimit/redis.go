@@ -0,0 +1,89 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// tokenBucketScript refills and consumes a token bucket atomically so that
+// concurrent requests across gateway replicas never double-spend a token.
+// KEYS[1] = bucket key
+// ARGV[1] = capacity, ARGV[2] = refill tokens/sec, ARGV[3] = now (unix seconds, float)
+// Returns {remaining, allowed (0/1)}.
+var tokenBucketScript = redis.NewScript(`
+local key = KEYS[1]
+local capacity = tonumber(ARGV[1])
+local refill_rate = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local data = redis.call("HMGET", key, "tokens", "ts")
+local tokens = tonumber(data[1])
+local ts = tonumber(data[2])
+if tokens == nil then
+	tokens = capacity
+	ts = now
+end
+
+local elapsed = math.max(0, now - ts)
+tokens = math.min(capacity, tokens + elapsed * refill_rate)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call("HMSET", key, "tokens", tokens, "ts", now)
+redis.call("EXPIRE", key, math.ceil(capacity / refill_rate) + 1)
+
+return {tostring(tokens), allowed}
+`)
+
+// RedisBackend shares rate-limit counters across gateway replicas via a
+// single Redis instance, using a Lua script to make read-modify-write of
+// the bucket atomic.
+type RedisBackend struct {
+	client *redis.Client
+}
+
+// NewRedisBackend wraps an existing Redis client.
+func NewRedisBackend(client *redis.Client) *RedisBackend {
+	return &RedisBackend{client: client}
+}
+
+func (b *RedisBackend) GetRateLimit(ctx context.Context, key string, algo Algorithm, limit int, duration time.Duration, burst int) (Result, error) {
+	capacity := float64(limit + burst)
+	if algo == LeakyBucket {
+		capacity = float64(limit)
+	}
+	refillRate := float64(limit) / duration.Seconds()
+	now := float64(time.Now().UnixNano()) / 1e9
+
+	res, err := tokenBucketScript.Run(ctx, b.client, []string{"ratelimit:" + key}, capacity, refillRate, now).Result()
+	if err != nil {
+		return Result{}, err
+	}
+
+	vals := res.([]interface{})
+	tokensLeft := vals[0].(string)
+	allowed := vals[1].(int64)
+
+	remaining, err := strconv.ParseFloat(tokensLeft, 64)
+	if err != nil {
+		return Result{}, err
+	}
+
+	resetIn := (capacity - remaining) / refillRate
+	result := Result{
+		Remaining: int(remaining),
+		ResetAt:   time.Now().Add(time.Duration(resetIn * float64(time.Second))),
+		Status:    StatusOK,
+	}
+	if allowed == 0 {
+		result.Status = StatusOverLimit
+	}
+	return result, nil
+}
@@ -0,0 +1,44 @@
+package ratelimit
+
+import "hash/fnv"
+
+// PeerTable resolves which gateway replica owns a given rate-limit key,
+// using rendezvous (highest random weight) hashing so every replica can
+// compute the same owner from an unchanging peer list without a shared
+// coordinator.
+type PeerTable struct {
+	Self  string
+	Peers []string
+}
+
+// Owner returns the peer address responsible for key. If this node is the
+// owner, self is true and the caller should serve the request locally
+// instead of forwarding it.
+//
+// Self is always weighed alongside Peers, whether or not the operator
+// included it in the GATEWAY_PEERS list. Peers naturally reads as "the
+// other nodes" given a separate GATEWAY_SELF_ADDR, but if Self is ever
+// left out of that list, every node would otherwise conclude it never
+// owns any key and forward 100% of requests — and two nodes with
+// complementary peer lists would forward to each other forever.
+func (t *PeerTable) Owner(key string) (addr string, self bool) {
+	if len(t.Peers) == 0 {
+		return t.Self, true
+	}
+
+	best, bestWeight := t.Self, weigh(t.Self, key)
+	for _, peer := range t.Peers {
+		if w := weigh(peer, key); w > bestWeight {
+			best, bestWeight = peer, w
+		}
+	}
+	return best, best == t.Self
+}
+
+func weigh(peer, key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(peer))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(key))
+	return h.Sum64()
+}
@@ -0,0 +1,46 @@
+package ratelimit
+
+import "testing"
+
+func TestPeerTableOwnerNoPeersIsAlwaysSelf(t *testing.T) {
+	table := &PeerTable{Self: "http://node-a"}
+	addr, self := table.Owner("some-key")
+	if !self || addr != "http://node-a" {
+		t.Fatalf("want (self, true) with no peers, got (%q, %v)", addr, self)
+	}
+}
+
+func TestPeerTableOwnerIsConsistentAcrossTablesSharingAKey(t *testing.T) {
+	peers := []string{"http://node-a", "http://node-b", "http://node-c"}
+
+	tableA := &PeerTable{Self: "http://node-a", Peers: peers}
+	tableB := &PeerTable{Self: "http://node-b", Peers: peers}
+
+	addrA, _ := tableA.Owner("tenant-42")
+	addrB, _ := tableB.Owner("tenant-42")
+	if addrA != addrB {
+		t.Fatalf("all replicas must agree on the owner for the same key: got %q vs %q", addrA, addrB)
+	}
+}
+
+func TestPeerTableOwnerDoesNotRequireSelfInPeers(t *testing.T) {
+	// GATEWAY_PEERS naturally reads as "the other nodes", so an operator
+	// omitting Self from it must not make every replica conclude it
+	// never owns any key (that would forward every request, and two
+	// replicas with complementary peer lists would forward to each
+	// other forever).
+	table := &PeerTable{Self: "http://node-a", Peers: []string{"http://node-b", "http://node-c"}}
+
+	sawSelfOwned := false
+	const samples = 500
+	for i := 0; i < samples; i++ {
+		key := string(rune('a' + i%26))
+		if _, self := table.Owner(key + string(rune(i))); self {
+			sawSelfOwned = true
+			break
+		}
+	}
+	if !sawSelfOwned {
+		t.Fatalf("node-a never owned any of %d sampled keys despite being absent from its own Peers list", samples)
+	}
+}
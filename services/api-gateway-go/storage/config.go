@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// newManagerFromEnv builds the backend selected by STORAGE_BACKEND from
+// the process environment; NewManager wraps the result with metrics.
+func newManagerFromEnv() (Manager, error) {
+	switch backend := os.Getenv("STORAGE_BACKEND"); backend {
+	case "minio", "s3":
+		endpoint := os.Getenv("STORAGE_ENDPOINT")
+		bucket := os.Getenv("STORAGE_BUCKET")
+		if endpoint == "" || bucket == "" {
+			return nil, fmt.Errorf("storage: STORAGE_ENDPOINT and STORAGE_BUCKET are required for backend %q", backend)
+		}
+		useSSL, _ := strconv.ParseBool(os.Getenv("STORAGE_USE_SSL"))
+		return NewMinIOManager(endpoint, os.Getenv("STORAGE_ACCESS_KEY"), os.Getenv("STORAGE_SECRET_KEY"), bucket, useSSL)
+	default:
+		dir := os.Getenv("STORAGE_LOCAL_DIR")
+		if dir == "" {
+			dir = "data/documents"
+		}
+		return NewLocalManager(dir)
+	}
+}
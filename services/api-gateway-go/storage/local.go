@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// LocalManager stores objects as plain files under a base directory. It
+// exists so the upload pipeline can be exercised without a running MinIO
+// or S3 endpoint (local dev, CI).
+type LocalManager struct {
+	baseDir string
+}
+
+// NewLocalManager ensures baseDir exists and returns a Manager backed by it.
+func NewLocalManager(baseDir string) (*LocalManager, error) {
+	if err := os.MkdirAll(baseDir, 0o755); err != nil {
+		return nil, err
+	}
+	return &LocalManager{baseDir: baseDir}, nil
+}
+
+func (m *LocalManager) path(key string) string {
+	return filepath.Join(m.baseDir, filepath.FromSlash(key))
+}
+
+func (m *LocalManager) Put(_ context.Context, key string, r io.Reader, _ int64, _ string) error {
+	dst := m.path(key)
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(f, r)
+	return err
+}
+
+func (m *LocalManager) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	return os.Open(m.path(key))
+}
+
+func (m *LocalManager) Delete(_ context.Context, key string) error {
+	err := os.Remove(m.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
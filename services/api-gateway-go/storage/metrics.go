@@ -0,0 +1,12 @@
+package storage
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var uploadBytesTotal = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "upload_bytes_total",
+	Help: "Total bytes committed to object storage through Manager.Put.",
+})
+
+func init() {
+	prometheus.MustRegister(uploadBytesTotal)
+}
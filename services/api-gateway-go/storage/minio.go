@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// MinIOManager stores objects in a MinIO or S3-compatible bucket.
+type MinIOManager struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewMinIOManager connects to endpoint (MinIO or S3) and ensures bucket
+// exists, creating it if necessary.
+func NewMinIOManager(endpoint, accessKey, secretKey, bucket string, useSSL bool) (*MinIOManager, error) {
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: useSSL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	exists, err := client.BucketExists(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &MinIOManager{client: client, bucket: bucket}, nil
+}
+
+func (m *MinIOManager) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := m.client.PutObject(ctx, m.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (m *MinIOManager) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return m.client.GetObject(ctx, m.bucket, key, minio.GetObjectOptions{})
+}
+
+func (m *MinIOManager) Delete(ctx context.Context, key string) error {
+	return m.client.RemoveObject(ctx, m.bucket, key, minio.RemoveObjectOptions{})
+}
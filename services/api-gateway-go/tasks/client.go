@@ -0,0 +1,135 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+)
+
+// Client enqueues work and reports back on it; it wraps the lower-level
+// asynq.Client/Inspector pair behind the vocabulary the gateway uses
+// (task IDs, statuses) instead of asynq's own types. It also keeps a
+// plain Redis connection alongside asynq's for the owner registry (see
+// ownership.go), since asynq itself has no concept of who enqueued a
+// task.
+type Client struct {
+	asynqClient    *asynq.Client
+	asynqInspector *asynq.Inspector
+	redis          *redis.Client
+}
+
+// NewClient connects to the Redis instance backing the task queue.
+func NewClient(redisAddr string) *Client {
+	opt := asynq.RedisClientOpt{Addr: redisAddr}
+	return &Client{
+		asynqClient:    asynq.NewClient(opt),
+		asynqInspector: asynq.NewInspector(opt),
+		redis:          redis.NewClient(&redis.Options{Addr: redisAddr}),
+	}
+}
+
+// Status is the polling-friendly view of a task's progress.
+type Status struct {
+	TaskID string          `json:"task_id"`
+	Status string          `json:"status"` // queued|running|done|failed
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// EnqueueQuery schedules a QueryPayload under a generated task ID,
+// recording owner as the task's owner, and returns the ID along with the
+// queue it was placed on.
+func (c *Client) EnqueueQuery(ctx context.Context, p QueryPayload, owner Owner) (taskID, queue string, err error) {
+	return c.enqueue(ctx, TypeQueryProcess, QueueQuery, p, owner)
+}
+
+// EnqueueGenerate schedules a GeneratePayload the same way.
+func (c *Client) EnqueueGenerate(ctx context.Context, p GeneratePayload, owner Owner) (taskID, queue string, err error) {
+	return c.enqueue(ctx, TypeGenerateProcess, QueueGenerate, p, owner)
+}
+
+type payload interface{ Marshal() ([]byte, error) }
+
+func (c *Client) enqueue(ctx context.Context, taskType, queue string, p payload, owner Owner) (taskID, queueName string, err error) {
+	body, err := p.Marshal()
+	if err != nil {
+		return "", "", err
+	}
+
+	// A random ID, not a predictable one: task-<timestamp> let any
+	// authenticated caller guess a nearby ID and read or cancel someone
+	// else's /query or /generate result.
+	id := uuid.NewString()
+	task := asynq.NewTask(taskType, body)
+	if _, err = c.asynqClient.EnqueueContext(ctx, task, asynq.TaskID(id), asynq.Queue(queue)); err != nil {
+		return "", "", err
+	}
+
+	if err := c.recordOwner(ctx, id, owner); err != nil {
+		// The task is already queued; failing the request over a
+		// bookkeeping write would strand it. Owns() denies access to
+		// unrecorded tasks, so the cost of this failing is the task
+		// becoming unreadable by anyone (safe), not leaking to everyone.
+		log.Printf("tasks: could not record owner for %s: %v", id, err)
+	}
+
+	return id, queue, nil
+}
+
+// Status fetches the current state of a previously enqueued task.
+func (c *Client) Status(queue, taskID string) (Status, error) {
+	info, err := c.asynqInspector.GetTaskInfo(queue, taskID)
+	if err != nil {
+		return Status{}, err
+	}
+
+	status := Status{TaskID: taskID}
+	switch info.State {
+	case asynq.TaskStateCompleted:
+		status.Status = "done"
+		status.Result = info.Result
+	case asynq.TaskStateArchived:
+		status.Status = "failed"
+		status.Error = info.LastErr
+	case asynq.TaskStateActive:
+		status.Status = "running"
+	default:
+		status.Status = "queued"
+	}
+	return status, nil
+}
+
+// Cancel stops a task: pending tasks are removed outright, while an
+// already-running task is asked to cancel its context cooperatively.
+func (c *Client) Cancel(queue, taskID string) error {
+	info, err := c.asynqInspector.GetTaskInfo(queue, taskID)
+	if errors.Is(err, asynq.ErrTaskNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.State == asynq.TaskStateActive {
+		return c.asynqInspector.CancelProcessing(taskID)
+	}
+	if err := c.asynqInspector.DeleteTask(queue, taskID); err != nil && !errors.Is(err, asynq.ErrTaskNotFound) {
+		return err
+	}
+	return nil
+}
+
+// Close releases the underlying Redis connections.
+func (c *Client) Close() error {
+	if err := c.asynqClient.Close(); err != nil {
+		return err
+	}
+	if err := c.asynqInspector.Close(); err != nil {
+		return err
+	}
+	return c.redis.Close()
+}
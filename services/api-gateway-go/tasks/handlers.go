@@ -0,0 +1,105 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+// queryResult and generateResult mirror the gateway's QueryResponse /
+// GenerateResponse JSON shape so a client reading a task's result gets
+// the same document whether it came from the sync or async path.
+type queryResult struct {
+	Query          string   `json:"query"`
+	Response       string   `json:"response"`
+	Sources        []string `json:"sources"`
+	ProcessingTime float64  `json:"processing_time"`
+	Status         string   `json:"status"`
+}
+
+type generateResult struct {
+	Prompt         string  `json:"prompt"`
+	Response       string  `json:"response"`
+	Model          string  `json:"model"`
+	TokensUsed     int     `json:"tokens_used"`
+	ProcessingTime float64 `json:"processing_time"`
+	Status         string  `json:"status"`
+}
+
+// ProcessQueryTask runs the retrieval+generation work for /query. Today
+// that's still the simulated response the synchronous handler used to
+// return; swapping in the real retrieval pipeline only touches this
+// function.
+func ProcessQueryTask(ctx context.Context, t *asynq.Task) error {
+	var p QueryPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return fmt.Errorf("tasks: unmarshal query payload: %w", err)
+	}
+
+	start := time.Now()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(0): // placeholder for real retrieval latency
+	}
+
+	result := queryResult{
+		Query:          p.Query,
+		Response:       fmt.Sprintf("This is a high-performance Go response for: %s", p.Query),
+		Sources:        []string{"go_source_1", "go_source_2", "go_source_3"},
+		ProcessingTime: time.Since(start).Seconds(),
+		Status:         "success",
+	}
+	return finish(t, p.CallbackURL, result)
+}
+
+// ProcessGenerateTask runs the LLM call backing /generate.
+func ProcessGenerateTask(ctx context.Context, t *asynq.Task) error {
+	var p GeneratePayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return fmt.Errorf("tasks: unmarshal generate payload: %w", err)
+	}
+
+	start := time.Now()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(0): // placeholder for real LLM latency
+	}
+
+	result := generateResult{
+		Prompt:         p.Prompt,
+		Response:       fmt.Sprintf("High-performance Go generated response for: %s", p.Prompt),
+		Model:          "go-gpt-4-turbo",
+		TokensUsed:     150,
+		ProcessingTime: time.Since(start).Seconds(),
+		Status:         "success",
+	}
+	return finish(t, p.CallbackURL, result)
+}
+
+func finish(t *asynq.Task, callbackURL string, result interface{}) error {
+	body, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	if _, err := t.ResultWriter().Write(body); err != nil {
+		return fmt.Errorf("tasks: write result: %w", err)
+	}
+	if err := notifyCallback(callbackURL, Status{Status: "done", Result: body}); err != nil {
+		log.Printf("tasks: callback to %s failed: %v", callbackURL, err)
+	}
+	return nil
+}
+
+// NewMux wires up the task types this gateway knows how to process.
+func NewMux() *asynq.ServeMux {
+	mux := asynq.NewServeMux()
+	mux.HandleFunc(TypeQueryProcess, ProcessQueryTask)
+	mux.HandleFunc(TypeGenerateProcess, ProcessGenerateTask)
+	return mux
+}
@@ -0,0 +1,45 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ownerTTL bounds how long an ownership record is kept — long enough to
+// cover any realistic polling window for a task's result, short enough
+// not to grow the keyspace forever.
+const ownerTTL = 24 * time.Hour
+
+func ownerKey(taskID string) string { return "task-owner:" + taskID }
+
+// recordOwner remembers which caller a task belongs to, so Owns can later
+// check a Status/Cancel request against it.
+func (c *Client) recordOwner(ctx context.Context, taskID string, owner Owner) error {
+	body, err := json.Marshal(owner)
+	if err != nil {
+		return err
+	}
+	return c.redis.Set(ctx, ownerKey(taskID), body, ownerTTL).Err()
+}
+
+// Owns reports whether owner is the caller a task was enqueued for. It
+// fails closed: a missing or unreadable ownership record denies access
+// rather than granting it, since the alternative is letting a predictable
+// or expired task ID be read by anyone.
+func (c *Client) Owns(ctx context.Context, taskID string, owner Owner) bool {
+	// Both a missing key (redis.Nil, TTL expired or never recorded) and a
+	// Redis error deny access: this is the guard the whole fix exists
+	// for, so an infrastructure hiccup must not silently become "everyone
+	// can read everyone's tasks."
+	body, err := c.redis.Get(ctx, ownerKey(taskID)).Bytes()
+	if err != nil {
+		return false
+	}
+
+	var recorded Owner
+	if err := json.Unmarshal(body, &recorded); err != nil {
+		return false
+	}
+	return recorded == owner
+}
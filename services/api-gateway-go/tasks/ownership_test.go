@@ -0,0 +1,42 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	return &Client{redis: redis.NewClient(&redis.Options{Addr: mr.Addr()})}
+}
+
+func TestOwnsDeniesWhenNoOwnerWasRecorded(t *testing.T) {
+	c := newTestClient(t)
+	if c.Owns(context.Background(), "task-1", Owner{Sub: "alice"}) {
+		t.Fatal("want false for a task ID with no recorded owner, got true")
+	}
+}
+
+func TestOwnsMatchesOnlyTheRecordedOwner(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+	owner := Owner{Sub: "alice", Tenant: "acme"}
+
+	if err := c.recordOwner(ctx, "task-1", owner); err != nil {
+		t.Fatalf("recordOwner: %v", err)
+	}
+
+	if !c.Owns(ctx, "task-1", owner) {
+		t.Fatal("want true for the caller the task was recorded for")
+	}
+	if c.Owns(ctx, "task-1", Owner{Sub: "mallory", Tenant: "acme"}) {
+		t.Fatal("want false for a different caller in the same tenant")
+	}
+	if c.Owns(ctx, "task-1", Owner{Sub: "alice", Tenant: "other-tenant"}) {
+		t.Fatal("want false for the same sub in a different tenant")
+	}
+}
@@ -0,0 +1,40 @@
+// Package tasks defines the asynq-backed payloads and task types shared
+// between the gateway (which enqueues) and the worker (which processes).
+package tasks
+
+import "encoding/json"
+
+const (
+	TypeQueryProcess    = "query:process"
+	TypeGenerateProcess = "generate:process"
+
+	QueueQuery    = "query"
+	QueueGenerate = "generate"
+)
+
+// QueryPayload mirrors the gateway's QueryRequest plus the bookkeeping the
+// worker needs to deliver a result (owning user, optional webhook).
+type QueryPayload struct {
+	Query             string            `json:"query"`
+	TopK              int               `json:"top_k"`
+	RetrievalStrategy string            `json:"retrieval_strategy"`
+	IncludeSources    bool              `json:"include_sources"`
+	UserContext       map[string]string `json:"user_context,omitempty"`
+	CallbackURL       string            `json:"callback_url,omitempty"`
+}
+
+// GeneratePayload mirrors the gateway's GenerateRequest.
+type GeneratePayload struct {
+	Prompt      string `json:"prompt"`
+	CallbackURL string `json:"callback_url,omitempty"`
+}
+
+func (p QueryPayload) Marshal() ([]byte, error)    { return json.Marshal(p) }
+func (p GeneratePayload) Marshal() ([]byte, error) { return json.Marshal(p) }
+
+// Owner identifies the caller a task was enqueued on behalf of, so a later
+// Status/Cancel call can be checked against whoever is asking.
+type Owner struct {
+	Sub    string `json:"sub"`
+	Tenant string `json:"tenant"`
+}
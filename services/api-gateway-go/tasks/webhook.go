@@ -0,0 +1,112 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// webhookClient posts callbacks over https only, through a dialer that
+// re-validates the resolved IP is not loopback/link-local/private.
+// callback_url comes straight from client request bodies, so without
+// this a client could point the worker at internal infrastructure (cloud
+// metadata endpoints, admin services) and have it make the request with
+// a WEBHOOK_SECRET-signed body to spare — classic SSRF.
+var webhookClient = &http.Client{
+	Timeout:   5 * time.Second,
+	Transport: &http.Transport{DialContext: dialPublicOnly},
+}
+
+// dialPublicOnly resolves addr and connects to the first IP that isn't
+// loopback/link-local/private/unspecified/multicast, rather than trusting
+// whatever validateCallbackURL saw at parse time — resolving again here
+// (instead of once up front) closes the DNS-rebinding gap where a
+// hostname resolves to a public IP during validation and a private one
+// at connect time.
+func dialPublicOnly(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	ips, err := net.DefaultResolver.LookupIP(ctx, "ip", host)
+	if err != nil {
+		return nil, err
+	}
+
+	var dialer net.Dialer
+	for _, ip := range ips {
+		if isPublicIP(ip) {
+			return dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+		}
+	}
+	return nil, fmt.Errorf("tasks: %s has no public IP to dial", host)
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsUnspecified() && !ip.IsPrivate() && !ip.IsMulticast()
+}
+
+// validateCallbackURL enforces the scheme allowlist. It intentionally
+// doesn't resolve the host itself — dialPublicOnly does that at actual
+// connect time, which is what protects against DNS rebinding.
+func validateCallbackURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("tasks: invalid callback_url: %w", err)
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("tasks: callback_url must use https, got %q", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("tasks: callback_url has no host")
+	}
+	return nil
+}
+
+// notifyCallback posts the task result to callbackURL, signing the body
+// with HMAC-SHA256 over WEBHOOK_SECRET so receivers can verify it came
+// from this gateway. callbackURL must be an https:// URL; see
+// validateCallbackURL and dialPublicOnly for the SSRF guards.
+func notifyCallback(callbackURL string, status Status) error {
+	if callbackURL == "" {
+		return nil
+	}
+	if err := validateCallbackURL(callbackURL); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, callbackURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Signature", sign(body))
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(os.Getenv("WEBHOOK_SECRET")))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,62 @@
+package tasks
+
+import (
+	"net"
+	"os"
+	"testing"
+)
+
+func TestValidateCallbackURLRejectsNonHTTPS(t *testing.T) {
+	cases := []struct {
+		url     string
+		wantErr bool
+	}{
+		{"https://example.com/hook", false},
+		{"http://example.com/hook", true},
+		{"ftp://example.com/hook", true},
+		{"https:///no-host", true},
+		{"not-a-url", true},
+	}
+	for _, tc := range cases {
+		err := validateCallbackURL(tc.url)
+		if tc.wantErr && err == nil {
+			t.Errorf("validateCallbackURL(%q): want error, got nil", tc.url)
+		}
+		if !tc.wantErr && err != nil {
+			t.Errorf("validateCallbackURL(%q): want no error, got %v", tc.url, err)
+		}
+	}
+}
+
+func TestIsPublicIPRejectsPrivateAndLoopbackRanges(t *testing.T) {
+	private := []string{"127.0.0.1", "169.254.169.254", "10.0.0.1", "192.168.1.1", "::1", "fe80::1"}
+	for _, addr := range private {
+		if isPublicIP(net.ParseIP(addr)) {
+			t.Errorf("isPublicIP(%q): want false, got true", addr)
+		}
+	}
+
+	public := []string{"93.184.216.34", "8.8.8.8"}
+	for _, addr := range public {
+		if !isPublicIP(net.ParseIP(addr)) {
+			t.Errorf("isPublicIP(%q): want true, got false", addr)
+		}
+	}
+}
+
+func TestSignIsDeterministicAndKeyedBySecret(t *testing.T) {
+	os.Setenv("WEBHOOK_SECRET", "test-secret")
+	defer os.Unsetenv("WEBHOOK_SECRET")
+
+	body := []byte(`{"status":"done"}`)
+	sig1 := sign(body)
+	sig2 := sign(body)
+	if sig1 != sig2 {
+		t.Fatalf("sign is not deterministic: %q != %q", sig1, sig2)
+	}
+
+	os.Setenv("WEBHOOK_SECRET", "different-secret")
+	if sig3 := sign(body); sig3 == sig1 {
+		t.Fatal("sign produced the same signature under a different WEBHOOK_SECRET")
+	}
+}